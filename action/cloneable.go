@@ -0,0 +1,15 @@
+package action
+
+// Cloneable represents a [Stateful] state value capable of producing an
+// independent copy of itself.
+//
+// It is consulted by `testo.ExecuteParallel`, which otherwise guards a
+// single `TState` value with a mutex so that it is never mutated by more
+// than one concurrently executing action at a time.  A state value
+// implementing Cloneable instead has an independent copy handed to each
+// concurrently executing action, allowing those actions to run without
+// contending on the shared state.
+type Cloneable[TState any] interface {
+	// Clone returns an independent copy of this state value.
+	Clone() TState
+}
@@ -0,0 +1,39 @@
+package action
+
+// GateResult represents the outcome of evaluating a single [Gate].
+type GateResult struct {
+	// Name is the name of the gate that produced this result.
+	Name string
+
+	// Required indicates whether a failure of this gate should fail the test.
+	Required bool
+
+	// Passed indicates whether the gate's criteria were met.
+	Passed bool
+
+	// Message provides additional detail on the gate's outcome, particularly
+	// useful when Passed is false.
+	Message string
+}
+
+// Gate represents a pass/fail criterion evaluated against the outcome of an
+// action run, as opposed to an ad-hoc `t.Fatal` call within an action's own
+// `Execute` method.
+//
+// Gates allow actions to declare assertions - for example latency thresholds,
+// span-count checks, or error-rate checks - that are evaluated once the full
+// action set has executed, and whose results determine the test's final
+// verdict without aborting execution part-way through.
+type Gate interface {
+	// Evaluate assesses whether this gate's criteria were met, returning the result.
+	Evaluate() GateResult
+}
+
+// Gated marks an [Action] as carrying one or more [Gate]s, to be evaluated
+// once the action set it belongs to has finished executing.
+type Gated interface {
+	Action
+
+	// Gates returns the set of [Gate]s attached to this action.
+	Gates() []Gate
+}
@@ -0,0 +1,18 @@
+package action
+
+// Parallelizable marks an [Action] as safe to execute concurrently with
+// other actions, as opposed to the implicit, purely serial execution order
+// used by [Stateful] actions run through `testo.Execute`.
+//
+// Dependencies returns the indices, within the action set it belongs to, of
+// actions that must complete before this action may begin executing.  These
+// are used to build the dependency DAG consulted by `testo.ExecuteParallel`
+// and `testo.Shard`; an action with no dependencies may run concurrently
+// with any other action in the set.
+type Parallelizable interface {
+	Action
+
+	// Dependencies returns the indices of actions, within the same action set,
+	// that must complete execution before this action may begin.
+	Dependencies() []int
+}
@@ -0,0 +1,41 @@
+package action
+
+// registeredTypes maps the name an [Action] implementation was registered
+// under via [RegisterType] to a constructor producing a fresh, empty
+// instance of that type.
+var registeredTypes = make(map[string]func() Action)
+
+// RegisterType registers the concrete [Action] struct type T, constructed via
+// its pointer type PT, under the given name - so that it may later be
+// reconstructed from JSON previously produced by `testo.Log`, for example
+// when replaying a failing test via `testo.Replay`.
+//
+// T must be the underlying struct type, and PT its pointer type implementing
+// [Action] - e.g. `action.RegisterType[StartCli, *StartCli]("*action.StartCli")`.
+// Requiring the pointer type PT ensures the constructed instance is always
+// addressable, which `testo.LoadActions` requires in order to
+// `json.Unmarshal` into it.
+//
+// `name` should match the `_type` value written into the JSON, which
+// defaults to `fmt.Sprintf("%T", a)` for actions logged via `testo.Log`.
+func RegisterType[T any, PT interface {
+	*T
+	Action
+}](name string) {
+	registeredTypes[name] = func() Action {
+		return PT(new(T))
+	}
+}
+
+// NewRegisteredType constructs a fresh, empty instance of the [Action] type
+// registered under `name` via [RegisterType].
+//
+// The second return value is false if no type has been registered under `name`.
+func NewRegisteredType(name string) (Action, bool) {
+	constructor, ok := registeredTypes[name]
+	if !ok {
+		return nil, false
+	}
+
+	return constructor(), true
+}
@@ -0,0 +1,36 @@
+package action
+
+import "testing"
+
+type recordedAction struct {
+	Value string
+}
+
+func (a *recordedAction) Execute() {}
+
+func TestRegisterType_ConstructsAddressablePointerInstances(t *testing.T) {
+	RegisterType[recordedAction, *recordedAction]("*action.recordedAction")
+
+	a, ok := NewRegisteredType("*action.recordedAction")
+	if !ok {
+		t.Fatal("expected *action.recordedAction to be registered")
+	}
+
+	recorded, ok := a.(*recordedAction)
+	if !ok {
+		t.Fatalf("expected a *recordedAction, got %T", a)
+	}
+
+	// The constructed instance must be addressable, as testo.LoadActions
+	// unmarshals JSON directly into whatever NewRegisteredType returns.
+	recorded.Value = "hello"
+	if recorded.Value != "hello" {
+		t.Fatal("expected constructed instance to be a settable pointer")
+	}
+}
+
+func TestNewRegisteredType_UnknownNameReturnsFalse(t *testing.T) {
+	if _, ok := NewRegisteredType("*action.doesNotExist"); ok {
+		t.Fatal("expected unregistered type name to return false")
+	}
+}
@@ -0,0 +1,15 @@
+package action
+
+// Repeatable represents an [Action] that may be executed more than once
+// within a single test.
+//
+// It is typically used alongside the `multiplier.Repeat` multiplier, which
+// expands any `Repeatable` action found within a set into `Runs` copies of
+// itself, allowing a test to request an action (or subsequence) be executed
+// N times without hand-duplicating it in the test body.
+type Repeatable interface {
+	Action
+
+	// Runs returns the number of times this action should be executed.
+	Runs() int
+}
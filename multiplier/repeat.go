@@ -0,0 +1,97 @@
+package multiplier
+
+import (
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+// RepeatName is the unique [Name] of the [Repeat] multiplier, for use with
+// [Register] and [Init].
+const RepeatName Name = "repeat"
+
+// runsEnvVarName is the environment variable used to scale the run count of
+// every [action.Repeatable] action uniformly, regardless of the value
+// returned by its own `Runs` method.
+const runsEnvVarName string = "TESTO_RUNS"
+
+// Repeat is a built-in [Multiplier] that expands any [action.Repeatable]
+// action within a set into `Runs` consecutive copies of itself.
+//
+// The number of copies produced for a given action is its own `Runs` value
+// multiplied by the value of the `TESTO_RUNS` environment variable, if set -
+// allowing an entire run to be scaled up or down (e.g. for flake-hunting or
+// perf-regression sweeps) without modifying individual tests.
+type Repeat struct{}
+
+var _ Multiplier = Repeat{}
+
+func (Repeat) Name() Name {
+	return RepeatName
+}
+
+// Apply expands every [action.Repeatable] action in `source` into N
+// consecutive copies of itself, where N is the action's own `Runs` value
+// multiplied by the global run multiplier sourced from the `TESTO_RUNS`
+// environment variable (defaulting to 1 if unset or invalid).  Non-repeatable
+// actions are left untouched.
+func (r Repeat) Apply(source action.Actions) action.Actions {
+	globalRuns := globalRunMultiplier()
+
+	result := make(action.Actions, 0, len(source))
+	for _, a := range source {
+		repeatable, ok := a.(action.Repeatable)
+		if !ok {
+			result = append(result, a)
+			continue
+		}
+
+		runs := repeatable.Runs() * globalRuns
+		for i := 0; i < runs; i++ {
+			result = append(result, cloneRepeatable(repeatable))
+		}
+	}
+
+	return result
+}
+
+// cloneRepeatable returns an independent copy of `a`, so that the copies
+// produced by [Repeat.Apply] do not alias the same instance.
+//
+// Aliasing the same instance is safe under the purely serial execution
+// performed by `testo.Execute`, but becomes a data race if the repeated
+// action also happens to be `action.Parallelizable` and is run concurrently
+// via `testo.ExecuteParallel`.
+//
+// Pointer-typed actions are shallow-copied via reflection; value-typed
+// actions are returned as-is, since Go's interface assignment semantics
+// already give each one an independent copy.
+func cloneRepeatable(a action.Repeatable) action.Repeatable {
+	v := reflect.ValueOf(a)
+	if v.Kind() != reflect.Ptr {
+		return a
+	}
+
+	clone := reflect.New(v.Elem().Type())
+	clone.Elem().Set(v.Elem())
+
+	return clone.Interface().(action.Repeatable)
+}
+
+// globalRunMultiplier returns the value of the `TESTO_RUNS` environment
+// variable, defaulting to 1 if it is unset or is not a valid positive integer.
+func globalRunMultiplier() int {
+	runsString, ok := os.LookupEnv(runsEnvVarName)
+	if !ok {
+		return 1
+	}
+
+	runs, err := strconv.Atoi(runsString)
+	if err != nil || runs < 1 {
+		return 1
+	}
+
+	return runs
+}
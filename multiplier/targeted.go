@@ -0,0 +1,113 @@
+package multiplier
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+// defaultMaxMatches is the default cap on the number of actions a single
+// [Targeted] multiplier may transform within a test, used when a zero
+// `max` is given to [NewTargeted].
+const defaultMaxMatches int = 50
+
+// Targeted wraps another [Multiplier], scoping its application to only those
+// actions whose concrete type name matches a regular expression, instead of
+// the full action set passed to [Apply].
+//
+// This mirrors the name-regex plus match-cap pattern used by sharding tools
+// to scope a modifier to a subset of a larger set, and allows e.g. a
+// `txn-commit` multiplier to apply only to `TxCreate`/`TxCommit`-shaped
+// actions within a large, mixed action list.
+type Targeted struct {
+	name    Name
+	pattern *regexp.Regexp
+	max     int
+	inner   Multiplier
+}
+
+var _ Multiplier = (*Targeted)(nil)
+
+// NewTargeted returns a new [Targeted] multiplier, scoping `inner` to only
+// those actions whose concrete type name (`fmt.Sprintf("%T", a)`) matches
+// `pattern`.
+//
+// `max` caps the number of actions that may be matched and passed to `inner`
+// within a single call to [Apply]; if zero, [defaultMaxMatches] is used instead.
+func NewTargeted(name Name, pattern *regexp.Regexp, max int, inner Multiplier) *Targeted {
+	if max == 0 {
+		max = defaultMaxMatches
+	}
+
+	return &Targeted{
+		name:    name,
+		pattern: pattern,
+		max:     max,
+		inner:   inner,
+	}
+}
+
+func (t *Targeted) Name() Name {
+	return t.name
+}
+
+// Apply walks `source`, collecting the indices of actions whose concrete type
+// name matches `t.pattern`, then passes each contiguous run of matched
+// actions to `t.inner`'s [Multiplier.Apply] separately, splicing each run's
+// result back in at that run's original position.
+//
+// Matched actions are split into contiguous runs, rather than batched
+// together as a single call to `t.inner`, so that non-matched actions
+// interleaved between two separate matches keep their original position
+// relative to the (possibly resized) transformed runs around them - e.g.
+// targeting `TxCreate`/`TxCommit` within `[TxCreate, Other, TxCommit]` must
+// not move `Other` to after `TxCommit`.
+//
+// If the number of matched actions exceeds `t.max`, Apply skips the
+// transformation entirely and returns `source` unmodified, rather than
+// aborting the whole test binary over what is a data-driven condition of the
+// particular test.
+func (t *Targeted) Apply(source action.Actions) action.Actions {
+	var matchedIndices []int
+	for i, a := range source {
+		if t.pattern.MatchString(fmt.Sprintf("%T", a)) {
+			matchedIndices = append(matchedIndices, i)
+		}
+	}
+
+	if len(matchedIndices) == 0 {
+		return source
+	}
+
+	if len(matchedIndices) > t.max {
+		return source
+	}
+
+	result := make(action.Actions, 0, len(source))
+	run := 0
+	for i := 0; i < len(source); {
+		if run >= len(matchedIndices) || matchedIndices[run] != i {
+			result = append(result, source[i])
+			i++
+			continue
+		}
+
+		runStart := run
+		for run+1 < len(matchedIndices) && matchedIndices[run+1] == matchedIndices[run]+1 {
+			run++
+		}
+
+		block := make(action.Actions, run-runStart+1)
+		for j := runStart; j <= run; j++ {
+			block[j-runStart] = source[matchedIndices[j]]
+		}
+
+		result = append(result, t.inner.Apply(block)...)
+
+		i = matchedIndices[run] + 1
+		run++
+	}
+
+	return result
+}
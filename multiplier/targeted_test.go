@@ -0,0 +1,106 @@
+package multiplier
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+type txAction struct {
+	id string
+}
+
+func (a *txAction) Execute() {}
+
+type otherAction struct {
+	id string
+}
+
+func (a *otherAction) Execute() {}
+
+func actionID(a action.Action) string {
+	switch v := a.(type) {
+	case *txAction:
+		return v.id
+	case *otherAction:
+		return v.id
+	default:
+		return ""
+	}
+}
+
+// idSuffixer replaces every [txAction] it is given with a new [txAction]
+// whose id has `'` appended, preserving the count and order of its input.
+type idSuffixer struct{}
+
+func (idSuffixer) Name() Name { return "id-suffixer" }
+
+func (idSuffixer) Apply(source action.Actions) action.Actions {
+	result := make(action.Actions, len(source))
+	for i, a := range source {
+		result[i] = &txAction{id: a.(*txAction).id + "'"}
+	}
+	return result
+}
+
+func TestTargetedApply_PreservesInterleavingOfNonMatchedActions(t *testing.T) {
+	source := action.Actions{
+		&txAction{id: "TxCreate"},
+		&otherAction{id: "Other"},
+		&txAction{id: "TxCommit"},
+	}
+
+	targeted := NewTargeted("txn-commit", regexp.MustCompile(`^\*multiplier\.txAction$`), 0, idSuffixer{})
+	result := targeted.Apply(source)
+
+	want := []string{"TxCreate'", "Other", "TxCommit'"}
+	if len(result) != len(want) {
+		t.Fatalf("unexpected result length: got %d, want %d (%v)", len(result), len(want), result)
+	}
+
+	for i, a := range result {
+		if got := actionID(a); got != want[i] {
+			t.Errorf("index %d: got %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestTargetedApply_TransformsContiguousMatchesInPlace(t *testing.T) {
+	source := action.Actions{
+		&txAction{id: "a"},
+		&txAction{id: "b"},
+		&otherAction{id: "Other"},
+	}
+
+	targeted := NewTargeted("txn-commit", regexp.MustCompile(`^\*multiplier\.txAction$`), 0, idSuffixer{})
+	result := targeted.Apply(source)
+
+	want := []string{"a'", "b'", "Other"}
+	if len(result) != len(want) {
+		t.Fatalf("unexpected result length: got %d, want %d (%v)", len(result), len(want), result)
+	}
+
+	for i, a := range result {
+		if got := actionID(a); got != want[i] {
+			t.Errorf("index %d: got %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestTargetedApply_SkipsWhenMatchesExceedMax(t *testing.T) {
+	source := action.Actions{
+		&txAction{id: "a"},
+		&txAction{id: "b"},
+	}
+
+	targeted := NewTargeted("txn-commit", regexp.MustCompile(`^\*multiplier\.txAction$`), 1, idSuffixer{})
+	result := targeted.Apply(source)
+
+	want := []string{"a", "b"}
+	for i, a := range result {
+		if got := actionID(a); got != want[i] {
+			t.Errorf("index %d: got %q, want %q, expected source to be left untouched", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package testo
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+// defaultParallelConcurrency is the concurrency limit used by
+// [ExecuteParallel] when [ParallelOptions.Concurrency] is zero or negative.
+const defaultParallelConcurrency int = 4
+
+// ParallelOptions configures [ExecuteParallel].
+type ParallelOptions struct {
+	// Concurrency caps the number of actions that may execute at once.  If
+	// zero or negative, [defaultParallelConcurrency] is used instead.
+	Concurrency int
+}
+
+// ExecuteParallel executes the given actions concurrently, respecting the
+// dependency DAG declared via [action.Parallelizable]: an action only begins
+// executing once every action named by its `Dependencies` has completed.
+// Actions that do not implement [action.Parallelizable] are assumed to have
+// no dependencies, and may run concurrently with any other action.
+//
+// `state` is set on every [action.Stateful] action via `SetState`, as with
+// [ExecuteS].  Because a single `TState` value must not be mutated by more
+// than one goroutine at once, actions receiving `state` are serialised
+// against one another with a shared mutex, unless `state` also implements
+// [action.Cloneable], in which case each action instead receives its own
+// independent clone and may run fully concurrently.
+//
+// Actions produced by [Shard] are transparently unwrapped via
+// [unwrapShardedAction] before being checked for [action.Stateful], so
+// sharding an action set has no effect on state injection.
+//
+// ExecuteParallel panics if the declared dependency graph contains a cycle
+// (including a self-dependency) or an out-of-range dependency index, rather
+// than leaving every goroutine blocked on `<-done[dependency]` forever - an
+// undetected cycle would otherwise hang the test binary until an external
+// CI timeout kills it.
+func ExecuteParallel[TState any](actions action.Actions, state TState, opts ParallelOptions) {
+	detectDependencyCycle(actions)
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultParallelConcurrency
+	}
+
+	done := make([]chan struct{}, len(actions))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	cloneable, stateIsCloneable := any(state).(action.Cloneable[TState])
+	var stateMutex sync.Mutex
+
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, a := range actions {
+		wg.Add(1)
+		go func(i int, a action.Action) {
+			defer wg.Done()
+			defer close(done[i])
+
+			if parallelizable, ok := a.(action.Parallelizable); ok {
+				for _, dependency := range parallelizable.Dependencies() {
+					<-done[dependency]
+				}
+			}
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			stateful, isStateful := unwrapShardedAction(a).(action.Stateful[TState])
+			switch {
+			case isStateful && stateIsCloneable:
+				stateful.SetState(cloneable.Clone())
+				a.Execute()
+			case isStateful:
+				stateMutex.Lock()
+				stateful.SetState(state)
+				a.Execute()
+				stateMutex.Unlock()
+			default:
+				a.Execute()
+			}
+		}(i, a)
+	}
+
+	wg.Wait()
+}
+
+// dependencyVisitState tracks a node's progress through the depth-first
+// traversal performed by [detectDependencyCycle].
+type dependencyVisitState int
+
+const (
+	dependencyUnvisited dependencyVisitState = iota
+	dependencyVisiting
+	dependencyVisited
+)
+
+// detectDependencyCycle panics if the dependency graph declared via
+// [action.Parallelizable.Dependencies] over `actions` contains a cycle
+// (including a self-dependency) or references an out-of-range index.
+func detectDependencyCycle(actions action.Actions) {
+	state := make([]dependencyVisitState, len(actions))
+
+	var visit func(i int, path []int)
+	visit = func(i int, path []int) {
+		if state[i] == dependencyVisited {
+			return
+		}
+		if state[i] == dependencyVisiting {
+			panic(fmt.Sprintf(
+				"testo: ExecuteParallel: circular dependency detected: %v",
+				append(append([]int{}, path...), i),
+			))
+		}
+
+		state[i] = dependencyVisiting
+		path = append(append([]int{}, path...), i)
+
+		if parallelizable, ok := actions[i].(action.Parallelizable); ok {
+			for _, dependency := range parallelizable.Dependencies() {
+				if dependency < 0 || dependency >= len(actions) {
+					panic(fmt.Sprintf(
+						"testo: ExecuteParallel: action %d declares out-of-range dependency %d",
+						i, dependency,
+					))
+				}
+
+				visit(dependency, path)
+			}
+		}
+
+		state[i] = dependencyVisited
+	}
+
+	for i := range actions {
+		visit(i, nil)
+	}
+}
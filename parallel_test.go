@@ -0,0 +1,151 @@
+package testo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+type orderRecordingAction struct {
+	id    string
+	deps  []int
+	mu    *sync.Mutex
+	order *[]string
+}
+
+func (a *orderRecordingAction) Execute() {
+	a.mu.Lock()
+	*a.order = append(*a.order, a.id)
+	a.mu.Unlock()
+}
+
+func (a *orderRecordingAction) Dependencies() []int {
+	return a.deps
+}
+
+func TestExecuteParallel_RespectsDeclaredDependencies(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+
+	a := &orderRecordingAction{id: "a", mu: &mu, order: &order}
+	b := &orderRecordingAction{id: "b", deps: []int{0}, mu: &mu, order: &order}
+	c := &orderRecordingAction{id: "c", deps: []int{1}, mu: &mu, order: &order}
+
+	ExecuteParallel(action.Actions{a, b, c}, struct{}{}, ParallelOptions{})
+
+	want := []string{"a", "b", "c"}
+	if len(order) != len(want) {
+		t.Fatalf("unexpected execution order: got %v, want %v", order, want)
+	}
+	for i, id := range want {
+		if order[i] != id {
+			t.Fatalf("unexpected execution order: got %v, want %v", order, want)
+		}
+	}
+}
+
+func TestExecuteParallel_PanicsOnCircularDependency(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected ExecuteParallel to panic on a circular dependency")
+		}
+	}()
+
+	var mu sync.Mutex
+	var order []string
+
+	a := &orderRecordingAction{id: "a", deps: []int{1}, mu: &mu, order: &order}
+	b := &orderRecordingAction{id: "b", deps: []int{0}, mu: &mu, order: &order}
+
+	ExecuteParallel(action.Actions{a, b}, struct{}{}, ParallelOptions{})
+}
+
+func TestExecuteParallel_PanicsOnSelfDependency(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected ExecuteParallel to panic on a self-dependency")
+		}
+	}()
+
+	var mu sync.Mutex
+	var order []string
+
+	a := &orderRecordingAction{id: "a", deps: []int{0}, mu: &mu, order: &order}
+
+	ExecuteParallel(action.Actions{a}, struct{}{}, ParallelOptions{})
+}
+
+type counterState struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (s *counterState) Clone() *counterState {
+	return &counterState{}
+}
+
+type statefulCounterAction struct {
+	state *counterState
+}
+
+func (a *statefulCounterAction) Execute() {
+	a.state.mu.Lock()
+	a.state.count++
+	a.state.mu.Unlock()
+}
+
+func (a *statefulCounterAction) SetState(s *counterState) {
+	a.state = s
+}
+
+func TestExecuteParallel_ClonesCloneableState(t *testing.T) {
+	actions := action.Actions{
+		&statefulCounterAction{},
+		&statefulCounterAction{},
+		&statefulCounterAction{},
+	}
+
+	ExecuteParallel(actions, &counterState{}, ParallelOptions{Concurrency: 3})
+
+	for i, a := range actions {
+		sa := a.(*statefulCounterAction)
+		if sa.state == nil || sa.state.count != 1 {
+			t.Errorf("action %d: expected its own cloned state incremented exactly once, got %+v", i, sa.state)
+		}
+	}
+}
+
+type sharedCounterAction struct {
+	state *sharedCounterState
+}
+
+type sharedCounterState struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (a *sharedCounterAction) Execute() {
+	a.state.mu.Lock()
+	a.state.count++
+	a.state.mu.Unlock()
+}
+
+func (a *sharedCounterAction) SetState(s *sharedCounterState) {
+	a.state = s
+}
+
+func TestExecuteParallel_SharesNonCloneableState(t *testing.T) {
+	shared := &sharedCounterState{}
+	actions := action.Actions{
+		&sharedCounterAction{},
+		&sharedCounterAction{},
+		&sharedCounterAction{},
+	}
+
+	ExecuteParallel(actions, shared, ParallelOptions{Concurrency: 3})
+
+	if shared.count != len(actions) {
+		t.Errorf("expected shared state to be incremented once per action, got %d", shared.count)
+	}
+}
@@ -0,0 +1,168 @@
+package testo
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+// shardedAction wraps an [action.Action] included in a [Shard] result,
+// overriding [action.Parallelizable.Dependencies] to report indices that are
+// local to the shard, rather than the original, pre-shard action set.
+//
+// The wrapped action is embedded unchanged, so `Execute` is unaffected.  The
+// wrapper itself only exposes `Execute` and `Dependencies`: other interfaces
+// implemented by the original action (e.g. [action.Stateful] or
+// [action.Gated]) are not visible on it. [unwrapShardedAction] recovers the
+// original action for callers that need to type-assert against interfaces
+// other than [action.Parallelizable].
+type shardedAction struct {
+	action.Action
+	deps []int
+}
+
+func (s *shardedAction) Dependencies() []int {
+	return s.deps
+}
+
+var _ action.Parallelizable = (*shardedAction)(nil)
+
+// unwrapShardedAction returns the original [action.Action] wrapped by [Shard]
+// in order to remap its dependency indices, or `a` itself if it was not
+// wrapped.
+func unwrapShardedAction(a action.Action) action.Action {
+	if sharded, ok := a.(*shardedAction); ok {
+		return sharded.Action
+	}
+
+	return a
+}
+
+// Shard deterministically partitions `actions` into `shardCount` shards and
+// returns the subset belonging to `shardIndex` (zero-based), preserving the
+// actions' original relative order.
+//
+// Actions connected by a declared [action.Parallelizable] dependency -
+// directly or transitively - are treated as a single atomic group and are
+// always assigned to the same shard, so that sharding a large,
+// multiplier-expanded action list across CI jobs never splits a dependency
+// across two shards.
+//
+// Because [action.Parallelizable.Dependencies] reports indices within the
+// action set it belongs to, any included action that declares dependencies
+// is wrapped so that it reports those dependencies' positions within the
+// returned shard, rather than their original positions within `actions`.
+// [ExecuteParallel] transparently unwraps these actions via
+// [unwrapShardedAction] when consulting interfaces other than
+// [action.Parallelizable].
+//
+// Shard panics if `shardCount` is not positive, or if `shardIndex` is not
+// within `[0, shardCount)`, rather than dividing by zero or silently
+// returning an empty shard for an out-of-range index - both of which are
+// easy to hit by accident when `shardIndex`/`shardCount` are sourced from a
+// CI matrix variable.
+func Shard(actions action.Actions, shardIndex int, shardCount int) action.Actions {
+	if shardCount <= 0 {
+		panic(fmt.Sprintf("testo: Shard: shardCount must be greater than zero, got %d", shardCount))
+	}
+	if shardIndex < 0 || shardIndex >= shardCount {
+		panic(fmt.Sprintf(
+			"testo: Shard: shardIndex must be within [0, %d), got %d",
+			shardCount, shardIndex,
+		))
+	}
+
+	groups := dependencyGroups(actions)
+
+	var includedIndices []int
+	for i, group := range groups {
+		if i%shardCount != shardIndex {
+			continue
+		}
+
+		includedIndices = append(includedIndices, group...)
+	}
+	sort.Ints(includedIndices)
+
+	oldToNew := make(map[int]int, len(includedIndices))
+	for newIndex, oldIndex := range includedIndices {
+		oldToNew[oldIndex] = newIndex
+	}
+
+	result := make(action.Actions, len(includedIndices))
+	for newIndex, oldIndex := range includedIndices {
+		a := actions[oldIndex]
+
+		parallelizable, ok := a.(action.Parallelizable)
+		if !ok {
+			result[newIndex] = a
+			continue
+		}
+
+		oldDeps := parallelizable.Dependencies()
+		newDeps := make([]int, 0, len(oldDeps))
+		for _, oldDep := range oldDeps {
+			if newDep, ok := oldToNew[oldDep]; ok {
+				newDeps = append(newDeps, newDep)
+			}
+		}
+
+		result[newIndex] = &shardedAction{Action: a, deps: newDeps}
+	}
+
+	return result
+}
+
+// dependencyGroups partitions the indices of `actions` into connected
+// groups, where two indices belong to the same group if either declares the
+// other as a dependency (directly or transitively) via
+// [action.Parallelizable].  Groups are returned in the order in which their
+// first member index was encountered, and each group's indices are sorted
+// ascending.
+func dependencyGroups(actions action.Actions) [][]int {
+	parent := make([]int, len(actions))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	union := func(a, b int) {
+		rootA, rootB := find(a), find(b)
+		if rootA != rootB {
+			parent[rootA] = rootB
+		}
+	}
+
+	for i, a := range actions {
+		if parallelizable, ok := a.(action.Parallelizable); ok {
+			for _, dependency := range parallelizable.Dependencies() {
+				union(i, dependency)
+			}
+		}
+	}
+
+	groupsByRoot := make(map[int][]int, len(actions))
+	order := make([]int, 0, len(actions))
+	for i := range actions {
+		root := find(i)
+		if _, ok := groupsByRoot[root]; !ok {
+			order = append(order, root)
+		}
+		groupsByRoot[root] = append(groupsByRoot[root], i)
+	}
+
+	groups := make([][]int, 0, len(order))
+	for _, root := range order {
+		groups = append(groups, groupsByRoot[root])
+	}
+
+	return groups
+}
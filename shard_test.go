@@ -0,0 +1,111 @@
+package testo
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+type depAction struct {
+	id   string
+	deps []int
+}
+
+func (a *depAction) Execute() {}
+
+func (a *depAction) Dependencies() []int {
+	return a.deps
+}
+
+func idOf(a action.Action) string {
+	return unwrapShardedAction(a).(*depAction).id
+}
+
+func TestShard_KeepsDependencyGroupsAtomic(t *testing.T) {
+	a0 := &depAction{id: "a0"}
+	a1 := &depAction{id: "a1", deps: []int{0}}
+	a2 := &depAction{id: "a2"}
+	a3 := &depAction{id: "a3"}
+
+	actions := action.Actions{a0, a1, a2, a3}
+
+	var got []string
+	for i := 0; i < 2; i++ {
+		for _, a := range Shard(actions, i, 2) {
+			got = append(got, idOf(a))
+		}
+	}
+
+	// a0 and a1 are linked by a declared dependency, and must therefore
+	// appear adjacent to one another, in their original relative order,
+	// regardless of which shard they land in.
+	indexA0, indexA1 := -1, -1
+	for i, id := range got {
+		switch id {
+		case "a0":
+			indexA0 = i
+		case "a1":
+			indexA1 = i
+		}
+	}
+
+	if indexA0 == -1 || indexA1 == -1 {
+		t.Fatalf("expected both a0 and a1 in combined shard output, got %v", got)
+	}
+	if indexA1 != indexA0+1 {
+		t.Fatalf("expected a0 and a1 to remain adjacent, got %v", got)
+	}
+}
+
+func TestShard_RemapsDependencyIndicesToShardLocalPositions(t *testing.T) {
+	a0 := &depAction{id: "a0"}
+	a1 := &depAction{id: "a1", deps: []int{0}}
+	a2 := &depAction{id: "a2"}
+
+	actions := action.Actions{a0, a1, a2}
+
+	// Force a0 and a1 into the same shard regardless of a2's assignment by
+	// using a single shard - this isolates the remap behaviour from the
+	// round-robin shard assignment itself.
+	result := Shard(actions, 0, 1)
+
+	for i, a := range result {
+		if idOf(a) != "a1" {
+			continue
+		}
+
+		parallelizable, ok := a.(action.Parallelizable)
+		if !ok {
+			t.Fatalf("expected a1 to remain action.Parallelizable after sharding")
+		}
+
+		deps := parallelizable.Dependencies()
+		if len(deps) != 1 {
+			t.Fatalf("expected a1 to have exactly one dependency, got %v", deps)
+		}
+
+		if got := result[deps[0]]; idOf(got) != "a0" {
+			t.Fatalf("expected the remapped dependency of a1 (at index %d) to resolve to a0, got %q", i, idOf(got))
+		}
+	}
+}
+
+func TestShard_PanicsOnInvalidShardCount(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Shard to panic on a non-positive shardCount")
+		}
+	}()
+
+	Shard(action.Actions{&depAction{id: "a0"}}, 0, 0)
+}
+
+func TestShard_PanicsOnOutOfRangeShardIndex(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Shard to panic on an out-of-range shardIndex")
+		}
+	}()
+
+	Shard(action.Actions{&depAction{id: "a0"}}, 2, 2)
+}
@@ -4,12 +4,19 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	stdT "testing"
 
 	"github.com/sourcenetwork/testo/action"
 	"github.com/sourcenetwork/testo/multiplier"
 )
 
+// logPathEnvVarName is the environment variable that, if set, causes [Log] to
+// additionally write the logged actions as JSON to the path it names, so that
+// a failing CI run may be captured and re-run locally via [Replay].
+const logPathEnvVarName string = "TESTO_LOG_PATH"
+
 // Execute this set of actions, serially, in order.
 func Execute(a action.Actions) {
 	for _, action := range a {
@@ -28,6 +35,39 @@ func ExecuteS[TState any](actions action.Actions, s TState) {
 	}
 }
 
+// ExecuteWithGates executes the given actions serially, in order, then
+// evaluates every [action.Gate] attached to a [action.Gated] action within
+// the set.
+//
+// A failure of a required gate will fail the test via `t.Errorf`; a failure
+// of a non-required gate is merely logged via `t.Logf`, and does not affect
+// the test's outcome.  This allows gates such as latency thresholds or
+// error-rate checks to be composed alongside the actions they observe,
+// rather than asserted upon with an ad-hoc `t.Fatal` inside `Execute`.
+func ExecuteWithGates(t stdT.TB, actions action.Actions) {
+	Execute(actions)
+
+	for _, a := range actions {
+		gated, ok := unwrapShardedAction(a).(action.Gated)
+		if !ok {
+			continue
+		}
+
+		for _, gate := range gated.Gates() {
+			result := gate.Evaluate()
+			if result.Passed {
+				continue
+			}
+
+			if result.Required {
+				t.Errorf("required gate failed. Name: %s, Message: %s", result.Name, result.Message)
+			} else {
+				t.Logf("gate failed. Name: %s, Message: %s", result.Name, result.Message)
+			}
+		}
+	}
+}
+
 // Log the set of active multipliers and the provided actions.
 //
 // The first line will be empty.
@@ -70,4 +110,73 @@ func Log(t stdT.TB, actions action.Actions) {
 	}
 
 	t.Logf("\nMultipliers: %s\nActions: %s", multiplier.Get(), string(jsonB))
+
+	if logPath, ok := os.LookupEnv(logPathEnvVarName); ok {
+		if err := os.WriteFile(logPath, jsonB, 0o644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+// LoadActions parses the JSON produced by [Log] (including a file captured
+// via the `TESTO_LOG_PATH` environment variable) back into a runnable
+// [action.Actions] set.
+//
+// Each element of the JSON array is expected to carry a `_type` property
+// identifying which [action.RegisterType]d action implementation to
+// construct; the remaining properties are then unmarshalled into a fresh
+// instance of that type.  An error is returned if an element's `_type` was
+// not registered.
+func LoadActions(r io.Reader) (action.Actions, error) {
+	var rawActions []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&rawActions); err != nil {
+		return nil, err
+	}
+
+	actions := make(action.Actions, 0, len(rawActions))
+	for _, raw := range rawActions {
+		var discriminator struct {
+			Type string `json:"_type"`
+		}
+		if err := json.Unmarshal(raw, &discriminator); err != nil {
+			return nil, err
+		}
+
+		a, ok := action.NewRegisteredType(discriminator.Type)
+		if !ok {
+			return nil, fmt.Errorf("action type not registered: %s", discriminator.Type)
+		}
+
+		if err := json.Unmarshal(raw, a); err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, a)
+	}
+
+	return actions, nil
+}
+
+// Replay reads a previously [Log]ged action set from `r` and [Execute]s it,
+// allowing a failing test captured via the `TESTO_LOG_PATH` environment
+// variable to be reproduced deterministically.
+func Replay(t stdT.TB, r io.Reader) {
+	actions, err := LoadActions(r)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	Execute(actions)
+}
+
+// ReplayS reads a previously [Log]ged action set from `r` and [ExecuteS]'s
+// it upon the given state, allowing a failing test that relies on
+// [action.Stateful] state injection to be reproduced deterministically.
+func ReplayS[TState any](t stdT.TB, r io.Reader, state TState) {
+	actions, err := LoadActions(r)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ExecuteS(actions, state)
 }
@@ -0,0 +1,51 @@
+package testo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sourcenetwork/testo/action"
+)
+
+type loggedAction struct {
+	TxnIndex int
+}
+
+func (a *loggedAction) Execute() {}
+
+func TestLoadActions_ParsesLogFormattedJSON(t *testing.T) {
+	action.RegisterType[loggedAction, *loggedAction]("*testo.loggedAction")
+
+	r := strings.NewReader(`[
+		{
+			"_type": "*testo.loggedAction",
+			"TxnIndex": 3
+		}
+	]`)
+
+	actions, err := LoadActions(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(actions) != 1 {
+		t.Fatalf("expected exactly one loaded action, got %d", len(actions))
+	}
+
+	loaded, ok := actions[0].(*loggedAction)
+	if !ok {
+		t.Fatalf("expected a *loggedAction, got %T", actions[0])
+	}
+
+	if loaded.TxnIndex != 3 {
+		t.Fatalf("expected TxnIndex 3, got %d", loaded.TxnIndex)
+	}
+}
+
+func TestLoadActions_UnregisteredTypeReturnsError(t *testing.T) {
+	r := strings.NewReader(`[{"_type": "*testo.neverRegistered"}]`)
+
+	if _, err := LoadActions(r); err == nil {
+		t.Fatal("expected an error for an unregistered action type")
+	}
+}